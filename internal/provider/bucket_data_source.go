@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &bucketDataSource{}
+	_ datasource.DataSourceWithConfigure = &bucketDataSource{}
+)
+
+// NewBucketDataSource is a helper function to simplify the provider implementation.
+func NewBucketDataSource() datasource.DataSource {
+	return &bucketDataSource{}
+}
+
+// bucketDataSource is the data source implementation.
+type bucketDataSource struct {
+	client *s3Client
+}
+
+// bucketDataSourceModel maps the data source schema data.
+type bucketDataSourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	Arn              types.String `tfsdk:"arn"`
+	Region           types.String `tfsdk:"region"`
+	CreationDate     types.String `tfsdk:"creation_date"`
+	Tags             types.Map    `tfsdk:"tags"`
+	VersioningStatus types.String `tfsdk:"versioning_status"`
+	WebsiteEndpoint  types.String `tfsdk:"website_endpoint"`
+}
+
+// Metadata returns the data source type name.
+func (d *bucketDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket"
+}
+
+// Schema defines the schema for the data source.
+func (d *bucketDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads metadata about an existing s3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "S3 Bucket Name",
+				Required:    true,
+			},
+			"arn": schema.StringAttribute{
+				Description: "ARN of the bucket",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "AWS region the bucket lives in",
+				Computed:    true,
+			},
+			"creation_date": schema.StringAttribute{
+				Description: "Date the bucket was created",
+				Computed:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags assigned to the bucket",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"versioning_status": schema.StringAttribute{
+				Description: "Versioning status of the bucket, e.g. Enabled, Suspended, or Disabled",
+				Computed:    true,
+			},
+			"website_endpoint": schema.StringAttribute{
+				Description: "Static website hosting endpoint for the bucket",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *bucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state bucketDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.Name.ValueString()
+
+	if _, err := d.client.Client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucketName)}); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading bucket",
+			"Could not read bucket "+bucketName+": "+err.Error(),
+		)
+		return
+	}
+
+	locationOutput, err := d.client.Client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading bucket location",
+			"Could not read location for bucket "+bucketName+": "+err.Error(),
+		)
+		return
+	}
+	region := string(locationOutput.LocationConstraint)
+	if region == "" {
+		region = "us-east-1"
+	}
+	state.Region = types.StringValue(region)
+	state.Arn = types.StringValue(fmt.Sprintf("arn:aws:s3:::%s", bucketName))
+	state.WebsiteEndpoint = types.StringValue(fmt.Sprintf("%s.s3-website-%s.amazonaws.com", bucketName, region))
+
+	// HeadBucket doesn't return a creation timestamp, so find it by listing
+	// buckets filtered to this name.
+	listOutput, err := d.client.Client.ListBuckets(ctx, &s3.ListBucketsInput{Prefix: aws.String(bucketName)})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading bucket creation date",
+			"Could not list buckets to find creation date for bucket "+bucketName+": "+err.Error(),
+		)
+		return
+	}
+	for _, b := range listOutput.Buckets {
+		if aws.ToString(b.Name) == bucketName && b.CreationDate != nil {
+			state.CreationDate = types.StringValue(b.CreationDate.Format(time.RFC3339))
+			break
+		}
+	}
+
+	tags := make(map[string]string)
+	tagsOutput, err := d.client.Client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		var apiErr smithy.APIError
+		if !errors.As(err, &apiErr) || apiErr.ErrorCode() != "NoSuchTagSet" {
+			resp.Diagnostics.AddError(
+				"Error reading bucket tags",
+				"Could not read tags for bucket "+bucketName+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		for _, tag := range tagsOutput.TagSet {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	tagsValue, diags := types.MapValueFrom(ctx, types.StringType, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Tags = tagsValue
+
+	versioningOutput, err := d.client.Client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading bucket versioning",
+			"Could not read versioning for bucket "+bucketName+": "+err.Error(),
+		)
+		return
+	}
+	versioningStatus := string(versioningOutput.Status)
+	if versioningStatus == "" {
+		versioningStatus = "Disabled"
+	}
+	state.VersioningStatus = types.StringValue(versioningStatus)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *bucketDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := resolveS3Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		d.client = client
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithConfigure = &bucketLifecycleConfigurationResource{}
+)
+
+// NewBucketLifecycleConfigurationResource is a helper function to simplify the provider implementation.
+func NewBucketLifecycleConfigurationResource() resource.Resource {
+	return &bucketLifecycleConfigurationResource{}
+}
+
+// bucketLifecycleConfigurationResource is the resource implementation.
+type bucketLifecycleConfigurationResource struct {
+	client *s3Client
+}
+
+// bucketLifecycleConfigurationResourceModel maps the resource schema data.
+type bucketLifecycleConfigurationResourceModel struct {
+	Bucket types.String                      `tfsdk:"bucket"`
+	Rule   []bucketLifecycleRuleResourceModel `tfsdk:"rule"`
+}
+
+// bucketLifecycleRuleResourceModel maps a single lifecycle rule block.
+type bucketLifecycleRuleResourceModel struct {
+	ID         types.String                             `tfsdk:"id"`
+	Status     types.String                             `tfsdk:"status"`
+	Prefix     types.String                             `tfsdk:"prefix"`
+	Transition []bucketLifecycleTransitionResourceModel `tfsdk:"transition"`
+	Expiration *bucketLifecycleExpirationResourceModel   `tfsdk:"expiration"`
+}
+
+// bucketLifecycleTransitionResourceModel maps a single transition block.
+type bucketLifecycleTransitionResourceModel struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+// bucketLifecycleExpirationResourceModel maps the expiration block.
+type bucketLifecycleExpirationResourceModel struct {
+	Days types.Int64 `tfsdk:"days"`
+}
+
+// Metadata returns the resource type name.
+func (r *bucketLifecycleConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_lifecycle_configuration"
+}
+
+// Schema defines the schema for the resource.
+func (r *bucketLifecycleConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the lifecycle configuration of an s3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "Name of the bucket to configure.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				Description: "Lifecycle rule.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier for the rule.",
+							Required:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Whether the rule is Enabled or Disabled.",
+							Required:    true,
+						},
+						"prefix": schema.StringAttribute{
+							Description: "Object key prefix the rule applies to.",
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"transition": schema.ListNestedBlock{
+							Description: "Transition to a different storage class after a number of days.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.Int64Attribute{
+										Description: "Number of days after object creation to transition.",
+										Required:    true,
+									},
+									"storage_class": schema.StringAttribute{
+										Description: "Storage class to transition the object into, e.g. STANDARD_IA or GLACIER.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"expiration": schema.SingleNestedBlock{
+							Description: "Expire objects after a number of days.",
+							Attributes: map[string]schema.Attribute{
+								"days": schema.Int64Attribute{
+									Description: "Number of days after object creation to expire the object.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildLifecycleRules translates the resource model into the API shape.
+func buildLifecycleRules(rules []bucketLifecycleRuleResourceModel) []s3types.LifecycleRule {
+	apiRules := make([]s3types.LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		apiRule := s3types.LifecycleRule{
+			ID:     aws.String(rule.ID.ValueString()),
+			Status: s3types.ExpirationStatus(rule.Status.ValueString()),
+			Filter: &s3types.LifecycleRuleFilter{
+				Prefix: aws.String(rule.Prefix.ValueString()),
+			},
+		}
+		for _, transition := range rule.Transition {
+			apiRule.Transitions = append(apiRule.Transitions, s3types.Transition{
+				Days:         aws.Int32(int32(transition.Days.ValueInt64())),
+				StorageClass: s3types.TransitionStorageClass(transition.StorageClass.ValueString()),
+			})
+		}
+		if rule.Expiration != nil && !rule.Expiration.Days.IsNull() {
+			apiRule.Expiration = &s3types.LifecycleExpiration{
+				Days: aws.Int32(int32(rule.Expiration.Days.ValueInt64())),
+			}
+		}
+		apiRules = append(apiRules, apiRule)
+	}
+	return apiRules
+}
+
+// putLifecycleConfiguration applies the plan's rules to the bucket.
+func (r *bucketLifecycleConfigurationResource) putLifecycleConfiguration(ctx context.Context, plan bucketLifecycleConfigurationResourceModel) error {
+	_, err := r.client.Client.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(plan.Bucket.ValueString()),
+		LifecycleConfiguration: &s3types.BucketLifecycleConfiguration{
+			Rules: buildLifecycleRules(plan.Rule),
+		},
+	})
+	return err
+}
+
+// Create a new resource.
+func (r *bucketLifecycleConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketLifecycleConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putLifecycleConfiguration(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting bucket lifecycle configuration",
+			"Could not set lifecycle configuration on bucket "+plan.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *bucketLifecycleConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketLifecycleConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := r.client.Client.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(state.Bucket.ValueString()),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchBucket" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading bucket lifecycle configuration",
+			"Could not read lifecycle configuration for bucket "+state.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	rules := make([]bucketLifecycleRuleResourceModel, 0, len(output.Rules))
+	for _, apiRule := range output.Rules {
+		rule := bucketLifecycleRuleResourceModel{
+			ID:     types.StringValue(aws.ToString(apiRule.ID)),
+			Status: types.StringValue(string(apiRule.Status)),
+		}
+		// Filter is nil for legacy rules that set Prefix directly on the
+		// rule instead of inside a Filter block.
+		if apiRule.Filter != nil {
+			rule.Prefix = types.StringValue(aws.ToString(apiRule.Filter.Prefix))
+		} else {
+			rule.Prefix = types.StringValue(aws.ToString(apiRule.Prefix))
+		}
+		for _, apiTransition := range apiRule.Transitions {
+			rule.Transition = append(rule.Transition, bucketLifecycleTransitionResourceModel{
+				Days:         types.Int64Value(int64(aws.ToInt32(apiTransition.Days))),
+				StorageClass: types.StringValue(string(apiTransition.StorageClass)),
+			})
+		}
+		if apiRule.Expiration != nil {
+			rule.Expiration = &bucketLifecycleExpirationResourceModel{
+				Days: types.Int64Value(int64(aws.ToInt32(apiRule.Expiration.Days))),
+			}
+		}
+		rules = append(rules, rule)
+	}
+	state.Rule = rules
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update applies changed rules.
+func (r *bucketLifecycleConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bucketLifecycleConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putLifecycleConfiguration(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating bucket lifecycle configuration",
+			"Could not set lifecycle configuration on bucket "+plan.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the lifecycle configuration from the bucket.
+func (r *bucketLifecycleConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketLifecycleConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Client.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(state.Bucket.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting bucket lifecycle configuration",
+			"Could not delete lifecycle configuration for bucket "+state.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bucketLifecycleConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := resolveS3Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bucketObjectResource{}
+	_ resource.ResourceWithConfigure   = &bucketObjectResource{}
+	_ resource.ResourceWithImportState = &bucketObjectResource{}
+)
+
+// NewBucketObjectResource is a helper function to simplify the provider implementation.
+func NewBucketObjectResource() resource.Resource {
+	return &bucketObjectResource{}
+}
+
+// bucketObjectResource is the resource implementation.
+type bucketObjectResource struct {
+	client *s3Client
+}
+
+// bucketObjectResourceModel maps the resource schema data.
+type bucketObjectResourceModel struct {
+	Bucket       types.String `tfsdk:"bucket"`
+	Key          types.String `tfsdk:"key"`
+	Content      types.String `tfsdk:"content"`
+	Source       types.String `tfsdk:"source"`
+	ContentType  types.String `tfsdk:"content_type"`
+	KMSKeyID     types.String `tfsdk:"kms_key_id"`
+	StorageClass types.String `tfsdk:"storage_class"`
+	ETag         types.String `tfsdk:"etag"`
+	VersionID    types.String `tfsdk:"version_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *bucketObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_object"
+}
+
+// Schema defines the schema for the resource.
+func (r *bucketObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an object within an s3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "Name of the bucket to put the object in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Full path, including file name, to the object inside the bucket.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Literal string content for the object. Ignored when source is set; setting both is not rejected.",
+				Optional:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Path to a local file to upload as the object. Takes precedence over content when both are set.",
+				Optional:    true,
+			},
+			"content_type": schema.StringAttribute{
+				Description: "Standard MIME type of the object.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"kms_key_id": schema.StringAttribute{
+				Description: "ARN of the KMS key to use for server-side encryption. When set, the object is encrypted with aws:kms instead of the bucket default.",
+				Optional:    true,
+			},
+			"storage_class": schema.StringAttribute{
+				Description: "Storage class to use for the object, e.g. STANDARD, STANDARD_IA, or GLACIER.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag generated by S3 for the uploaded object.",
+				Computed:    true,
+			},
+			"version_id": schema.StringAttribute{
+				Description: "Version ID of the object, if the bucket has versioning enabled.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// objectBody resolves the bytes to upload from either content or source.
+func objectBody(plan bucketObjectResourceModel) (string, error) {
+	if !plan.Source.IsNull() && plan.Source.ValueString() != "" {
+		data, err := os.ReadFile(plan.Source.ValueString())
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return plan.Content.ValueString(), nil
+}
+
+// Create a new resource.
+func (r *bucketObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := objectBody(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading source file",
+			"Could not read source file for object "+plan.Key.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(plan.Bucket.ValueString()),
+		Key:    aws.String(plan.Key.ValueString()),
+		Body:   strings.NewReader(body),
+	}
+	if !plan.ContentType.IsNull() && !plan.ContentType.IsUnknown() {
+		input.ContentType = aws.String(plan.ContentType.ValueString())
+	}
+	if !plan.StorageClass.IsNull() && !plan.StorageClass.IsUnknown() {
+		input.StorageClass = s3types.StorageClass(plan.StorageClass.ValueString())
+	}
+	if !plan.KMSKeyID.IsNull() && plan.KMSKeyID.ValueString() != "" {
+		input.ServerSideEncryption = s3types.ServerSideEncryptionAwsKms
+		input.SSEKMSKeyId = aws.String(plan.KMSKeyID.ValueString())
+	}
+
+	output, err := r.client.Client.PutObject(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating bucket object",
+			"Could not upload object "+plan.Key.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ETag = types.StringValue(strings.Trim(aws.ToString(output.ETag), "\""))
+	plan.VersionID = types.StringValue(aws.ToString(output.VersionId))
+	plan.ContentType = types.StringValue(aws.ToString(input.ContentType))
+	plan.StorageClass = types.StringValue(string(input.StorageClass))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *bucketObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := r.client.Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(state.Bucket.ValueString()),
+		Key:    aws.String(state.Key.ValueString()),
+	})
+	if err != nil {
+		var notFound *s3types.NotFound
+		if errors.As(err, &notFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading bucket object",
+			"Could not read object "+state.Key.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ETag = types.StringValue(strings.Trim(aws.ToString(output.ETag), "\""))
+	state.VersionID = types.StringValue(aws.ToString(output.VersionId))
+	state.ContentType = types.StringValue(aws.ToString(output.ContentType))
+	state.StorageClass = types.StringValue(string(output.StorageClass))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update re-uploads the object whenever its body or metadata changes.
+func (r *bucketObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bucketObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := objectBody(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading source file",
+			"Could not read source file for object "+plan.Key.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(plan.Bucket.ValueString()),
+		Key:    aws.String(plan.Key.ValueString()),
+		Body:   strings.NewReader(body),
+	}
+	if !plan.ContentType.IsNull() && !plan.ContentType.IsUnknown() {
+		input.ContentType = aws.String(plan.ContentType.ValueString())
+	}
+	if !plan.StorageClass.IsNull() && !plan.StorageClass.IsUnknown() {
+		input.StorageClass = s3types.StorageClass(plan.StorageClass.ValueString())
+	}
+	if !plan.KMSKeyID.IsNull() && plan.KMSKeyID.ValueString() != "" {
+		input.ServerSideEncryption = s3types.ServerSideEncryptionAwsKms
+		input.SSEKMSKeyId = aws.String(plan.KMSKeyID.ValueString())
+	}
+
+	output, err := r.client.Client.PutObject(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating bucket object",
+			"Could not upload object "+plan.Key.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ETag = types.StringValue(strings.Trim(aws.ToString(output.ETag), "\""))
+	plan.VersionID = types.StringValue(aws.ToString(output.VersionId))
+	plan.ContentType = types.StringValue(aws.ToString(input.ContentType))
+	plan.StorageClass = types.StringValue(string(input.StorageClass))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete the object.
+func (r *bucketObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(state.Bucket.ValueString()),
+		Key:    aws.String(state.Key.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting bucket object",
+			"Could not delete object "+state.Key.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState adopts a pre-existing object, e.g.
+// `terraform import s3_bucket_object.foo my-bucket/path/to/object`.
+func (r *bucketObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bucket, key, found := strings.Cut(req.ID, "/")
+	if !found {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier in the form bucket/key, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), bucket)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bucketObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := resolveS3Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
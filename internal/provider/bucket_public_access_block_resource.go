@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &bucketPublicAccessBlockResource{}
+	_ resource.ResourceWithConfigure = &bucketPublicAccessBlockResource{}
+)
+
+// NewBucketPublicAccessBlockResource is a helper function to simplify the provider implementation.
+func NewBucketPublicAccessBlockResource() resource.Resource {
+	return &bucketPublicAccessBlockResource{}
+}
+
+// bucketPublicAccessBlockResource is the resource implementation.
+type bucketPublicAccessBlockResource struct {
+	client *s3Client
+}
+
+// bucketPublicAccessBlockResourceModel maps the resource schema data.
+type bucketPublicAccessBlockResourceModel struct {
+	Bucket                types.String `tfsdk:"bucket"`
+	BlockPublicACLs       types.Bool   `tfsdk:"block_public_acls"`
+	BlockPublicPolicy     types.Bool   `tfsdk:"block_public_policy"`
+	IgnorePublicACLs      types.Bool   `tfsdk:"ignore_public_acls"`
+	RestrictPublicBuckets types.Bool   `tfsdk:"restrict_public_buckets"`
+}
+
+// Metadata returns the resource type name.
+func (r *bucketPublicAccessBlockResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_public_access_block"
+}
+
+// Schema defines the schema for the resource.
+func (r *bucketPublicAccessBlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the public access block configuration of an s3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "Name of the bucket to configure.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block_public_acls": schema.BoolAttribute{
+				Description: "Block public access through new ACLs and uploads with public ACLs.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"block_public_policy": schema.BoolAttribute{
+				Description: "Block public and cross-account access to the bucket via public bucket policies.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"ignore_public_acls": schema.BoolAttribute{
+				Description: "Ignore public ACLs on the bucket and objects within it.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"restrict_public_buckets": schema.BoolAttribute{
+				Description: "Restrict access to the bucket and its objects to only AWS service principals and authorized users.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// putPublicAccessBlock applies the plan's settings to the bucket.
+func (r *bucketPublicAccessBlockResource) putPublicAccessBlock(ctx context.Context, plan bucketPublicAccessBlockResourceModel) error {
+	_, err := r.client.Client.PutPublicAccessBlock(ctx, &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(plan.Bucket.ValueString()),
+		PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(plan.BlockPublicACLs.ValueBool()),
+			BlockPublicPolicy:     aws.Bool(plan.BlockPublicPolicy.ValueBool()),
+			IgnorePublicAcls:      aws.Bool(plan.IgnorePublicACLs.ValueBool()),
+			RestrictPublicBuckets: aws.Bool(plan.RestrictPublicBuckets.ValueBool()),
+		},
+	})
+	return err
+}
+
+// Create a new resource.
+func (r *bucketPublicAccessBlockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketPublicAccessBlockResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putPublicAccessBlock(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting bucket public access block",
+			"Could not set public access block on bucket "+plan.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *bucketPublicAccessBlockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketPublicAccessBlockResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := r.client.Client.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(state.Bucket.ValueString()),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchBucket" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading bucket public access block",
+			"Could not read public access block for bucket "+state.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if output.PublicAccessBlockConfiguration != nil {
+		config := output.PublicAccessBlockConfiguration
+		state.BlockPublicACLs = types.BoolValue(aws.ToBool(config.BlockPublicAcls))
+		state.BlockPublicPolicy = types.BoolValue(aws.ToBool(config.BlockPublicPolicy))
+		state.IgnorePublicACLs = types.BoolValue(aws.ToBool(config.IgnorePublicAcls))
+		state.RestrictPublicBuckets = types.BoolValue(aws.ToBool(config.RestrictPublicBuckets))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update applies changed settings.
+func (r *bucketPublicAccessBlockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bucketPublicAccessBlockResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putPublicAccessBlock(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating bucket public access block",
+			"Could not set public access block on bucket "+plan.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the public access block configuration from the bucket.
+func (r *bucketPublicAccessBlockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketPublicAccessBlockResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Client.DeletePublicAccessBlock(ctx, &s3.DeletePublicAccessBlockInput{
+		Bucket: aws.String(state.Bucket.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting bucket public access block",
+			"Could not delete public access block for bucket "+state.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bucketPublicAccessBlockResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := resolveS3Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
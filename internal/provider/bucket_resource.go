@@ -5,25 +5,36 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"log"
-	"os"
-	"strings"
+	"errors"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &orderResource{}
-	_ resource.ResourceWithConfigure = &orderResource{}
+	_ resource.Resource                = &orderResource{}
+	_ resource.ResourceWithConfigure   = &orderResource{}
+	_ resource.ResourceWithImportState = &orderResource{}
+	_ resource.ResourceWithModifyPlan  = &orderResource{}
+)
+
+// defaultCreateTimeout and defaultDeleteTimeout are used when the user
+// doesn't configure a timeouts block.
+const (
+	defaultCreateTimeout = 5 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -33,15 +44,18 @@ func NewOrderResource() resource.Resource {
 
 // orderResource is the resource implementation.
 type orderResource struct {
-	client *session.Session
+	client *s3Client
 }
 
 // orderResourceModel maps the resource schema data.
 type orderResourceModel struct {
-	Date        tftypes.String `tfsdk:"date"`
-	Name        tftypes.String `tfsdk:"name"`
-	Tags        tftypes.String `tfsdk:"tags"`
-	LastUpdated tftypes.String `tfsdk:"last_updated"`
+	Date         tftypes.String `tfsdk:"date"`
+	Name         tftypes.String `tfsdk:"name"`
+	Tags         tftypes.Map    `tfsdk:"tags"`
+	TagsAll      tftypes.Map    `tfsdk:"tags_all"`
+	ForceDestroy tftypes.Bool   `tfsdk:"force_destroy"`
+	LastUpdated  tftypes.String `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -50,7 +64,7 @@ func (r *orderResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 // Schema defines the schema for the resource.
-func (r *orderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *orderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an s3 bucket.",
 		Attributes: map[string]schema.Attribute{
@@ -66,17 +80,34 @@ func (r *orderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "S3 Bucket Name",
 				Required:    true,
 			},
-			"tags": schema.StringAttribute{
-				Description: "S3 Bucket Tags",
-				Required:    true,
+			"tags": schema.MapAttribute{
+				Description: "S3 Bucket Tags. Merged with the provider's default_tags to form tags_all, with this resource's tags winning on conflict.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tags_all": schema.MapAttribute{
+				Description: "Map of tags assigned to the bucket, including those inherited from the provider's default_tags configuration block.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Whether to empty the bucket of all objects and versions before deleting it. Without this, destroying a non-empty bucket fails.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 // Create a new resource.
 func (r *orderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-
 	var plan orderResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -84,10 +115,15 @@ func (r *orderResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// for index, item := range plan.Buckets {
-	// Create an S3 service client
-	svc := s3.New(r.client)
-	awsStringBucket := strings.Replace(plan.Name.String(), "\"", "", -1)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	awsStringBucket := plan.Name.ValueString()
 
 	// Create input parameters for the CreateBucket operation
 	input := &s3.CreateBucketInput{
@@ -95,7 +131,7 @@ func (r *orderResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Execute the CreateBucket operation
-	_, err := svc.CreateBucket(input)
+	_, err := r.client.Client.CreateBucket(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating order",
@@ -104,30 +140,38 @@ func (r *orderResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Add tags
-	var tags []*s3.Tag
-	tagValue := strings.Replace(plan.Tags.String(), "\"", "", -1)
-	tags = append(tags, &s3.Tag{
-		Key:   aws.String("tfkey"),
-		Value: aws.String(tagValue),
-	})
-
-	_, err = svc.PutBucketTagging(&s3.PutBucketTaggingInput{
-		Bucket: aws.String(awsStringBucket),
-		Tagging: &s3.Tagging{
-			TagSet: tags,
-		},
-	})
-	if err != nil {
-		fmt.Println("Error adding tags to the bucket:", err)
-		return
+	resourceTags := make(map[string]string)
+	if !plan.Tags.IsNull() {
+		diags = plan.Tags.ElementsAs(ctx, &resourceTags, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	effectiveTags := mergeTags(r.client.DefaultTags, resourceTags)
+
+	if len(effectiveTags) > 0 {
+		_, err = r.client.Client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+			Bucket:  aws.String(awsStringBucket),
+			Tagging: &s3types.Tagging{TagSet: tagsToS3(effectiveTags)},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error tagging bucket",
+				"Bucket "+awsStringBucket+" was created but could not be tagged: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	fmt.Printf("Bucket %s created successfully\n", plan.Name)
-
-	plan.Name = types.StringValue(awsStringBucket)
 	plan.Date = types.StringValue(time.Now().Format(time.RFC850))
-	plan.Tags = types.StringValue(tagValue)
+
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, effectiveTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAll
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -148,17 +192,66 @@ func (r *orderResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	awsStringBucket := strings.Replace(state.Name.String(), "\"", "", -1)
+	awsStringBucket := state.Name.ValueString()
 
-	svc := s3.New(r.client)
 	params := &s3.HeadBucketInput{
 		Bucket: aws.String(awsStringBucket),
 	}
 
-	_, err := svc.HeadBucket(params)
+	_, err := r.client.Client.HeadBucket(ctx, params)
+	if err != nil {
+		var respErr *http.ResponseError
+		if errors.As(err, &respErr) && respErr.HTTPStatusCode() == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading bucket",
+			"Could not read bucket "+awsStringBucket+": "+err.Error(),
+		)
+		return
+	}
+
+	tags := make(map[string]string)
+	tagsOutput, err := r.client.Client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String(awsStringBucket)})
 	if err != nil {
-		fmt.Println("Error getting bucket information:", err)
-		os.Exit(1)
+		var apiErr smithy.APIError
+		if !errors.As(err, &apiErr) || apiErr.ErrorCode() != "NoSuchTagSet" {
+			resp.Diagnostics.AddError(
+				"Error reading bucket tags",
+				"Could not read tags for bucket "+awsStringBucket+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		for _, tag := range tagsOutput.TagSet {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.TagsAll = tagsAll
+
+	// With no resource tags in state (e.g. right after import), take them from
+	// the remote tag set, leaving out tags that match a provider default tag.
+	if state.Tags.IsNull() {
+		resourceTags := make(map[string]string)
+		for k, v := range tags {
+			if dv, ok := r.client.DefaultTags[k]; !ok || dv != v {
+				resourceTags[k] = v
+			}
+		}
+		if len(resourceTags) > 0 {
+			tagsValue, diags := types.MapValueFrom(ctx, types.StringType, resourceTags)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			state.Tags = tagsValue
+		}
 	}
 
 	// Set refreshed state
@@ -170,40 +263,66 @@ func (r *orderResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *orderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan orderResourceModel
+	// Retrieve values from plan and prior state
+	var plan, state orderResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Create an S3 service client
-	svc := s3.New(r.client)
-	awsStringBucket := strings.Replace(plan.Name.String(), "\"", "", -1)
+	awsStringBucket := plan.Name.ValueString()
 
-	// Add tags
-	var tags []*s3.Tag
-	tagValue := strings.Replace(plan.Tags.String(), "\"", "", -1)
-	tags = append(tags, &s3.Tag{
-		Key:   aws.String("tfkey"),
-		Value: aws.String(tagValue),
-	})
+	resourceTags := make(map[string]string)
+	if !plan.Tags.IsNull() {
+		diags = plan.Tags.ElementsAs(ctx, &resourceTags, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	effectiveTags := mergeTags(r.client.DefaultTags, resourceTags)
+
+	priorTags := make(map[string]string)
+	if !state.TagsAll.IsNull() {
+		diags = state.TagsAll.ElementsAs(ctx, &priorTags, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	_, err := svc.PutBucketTagging(&s3.PutBucketTaggingInput{
-		Bucket: aws.String(awsStringBucket),
-		Tagging: &s3.Tagging{
-			TagSet: tags,
-		},
-	})
-	if err != nil {
-		fmt.Println("Error adding tags to the bucket:", err)
-		return
+	if !tagsEqual(priorTags, effectiveTags) {
+		var err error
+		if len(effectiveTags) == 0 {
+			_, err = r.client.Client.DeleteBucketTagging(ctx, &s3.DeleteBucketTaggingInput{
+				Bucket: aws.String(awsStringBucket),
+			})
+		} else {
+			_, err = r.client.Client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+				Bucket:  aws.String(awsStringBucket),
+				Tagging: &s3types.Tagging{TagSet: tagsToS3(effectiveTags)},
+			})
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating bucket tags",
+				"Could not update tags on bucket "+awsStringBucket+": "+err.Error(),
+			)
+			return
+		}
 	}
 
-	plan.Name = types.StringValue(strings.Replace(awsStringBucket, "\"", "", -1))
 	plan.Date = types.StringValue(time.Now().Format(time.RFC850))
-	plan.Tags = types.StringValue(strings.Replace(tagValue, "\"", "", -1))
+
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, effectiveTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAll
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -222,34 +341,104 @@ func (r *orderResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	svc := s3.New(r.client)
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	awsStringBucket := state.Name.ValueString()
+
+	if state.ForceDestroy.ValueBool() {
+		if err := emptyBucket(ctx, r.client.Client, awsStringBucket); err != nil {
+			resp.Diagnostics.AddError(
+				"Error emptying bucket",
+				"Could not empty bucket "+awsStringBucket+" before deletion: "+err.Error(),
+			)
+			return
+		}
+	}
 
 	input := &s3.DeleteBucketInput{
-		Bucket: aws.String(strings.Replace(state.Name.String(), "\"", "", -1)),
+		Bucket: aws.String(awsStringBucket),
 	}
 
-	_, err := svc.DeleteBucket(input)
+	_, err := r.client.Client.DeleteBucket(ctx, input)
 	if err != nil {
-		log.Fatalf("failed to delete bucket, %v", err)
+		resp.Diagnostics.AddError(
+			"Error deleting bucket",
+			"Could not delete bucket, unexpected error: "+err.Error(),
+		)
+		return
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *orderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+// emptyBucket deletes every object version and delete marker in bucket,
+// paginating through ListObjectVersions until it reports no further pages.
+// It's used to support force_destroy, since S3 refuses to delete a
+// non-empty bucket.
+func emptyBucket(ctx context.Context, client *s3.Client, bucket string) error {
+	var keyMarker, versionIDMarker *string
+
+	for {
+		listOutput, err := client.ListObjectVersions(ctx, &s3.ListObjectVersionsInput{
+			Bucket:          aws.String(bucket),
+			KeyMarker:       keyMarker,
+			VersionIdMarker: versionIDMarker,
+		})
+		if err != nil {
+			return err
+		}
+
+		var objects []s3types.ObjectIdentifier
+		for _, version := range listOutput.Versions {
+			objects = append(objects, s3types.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range listOutput.DeleteMarkers {
+			objects = append(objects, s3types.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+
+		if len(objects) > 0 {
+			if _, err := client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+				Bucket: aws.String(bucket),
+				Delete: &s3types.Delete{Objects: objects},
+			}); err != nil {
+				return err
+			}
+		}
+
+		if !aws.ToBool(listOutput.IsTruncated) {
+			return nil
+		}
+		keyMarker = listOutput.NextKeyMarker
+		versionIDMarker = listOutput.NextVersionIdMarker
 	}
+}
 
-	client, ok := req.ProviderData.(*session.Session)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *session.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+// ImportState adopts a pre-existing bucket by name, e.g.
+// `terraform import s3_bucket.foo my-bucket-name`.
+func (r *orderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
 
+// ModifyPlan marks tags_all as unknown when the provider's default_tags.tags
+// value isn't known yet (e.g. it references another resource's computed
+// output), so Terraform defers the tag merge instead of planning as if no
+// default tags were set.
+func (r *orderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || !r.client.DefaultTagsUnknown {
 		return
 	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.MapUnknown(types.StringType))...)
+}
 
-	r.client = client
+// Configure adds the provider configured client to the resource.
+func (r *orderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := resolveS3Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
 }
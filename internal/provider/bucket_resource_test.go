@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccBucketResource covers create, import, tag updates, and
+// force-destroy of the s3_bucket resource against LocalStack.
+func TestAccBucketResource(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read.
+			{
+				Config: testAccProviderConfig() + testAccBucketResourceConfig(bucketName, map[string]string{"Environment": "test"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("s3_bucket.test", "name", bucketName),
+					resource.TestCheckResourceAttr("s3_bucket.test", "tags.Environment", "test"),
+					resource.TestCheckResourceAttr("s3_bucket.test", "tags_all.Environment", "test"),
+					resource.TestCheckResourceAttrSet("s3_bucket.test", "last_updated"),
+				),
+			},
+			// Import. date/last_updated are local bookkeeping timestamps set
+			// at apply time, not refreshed from the API, so a freshly
+			// imported resource never populates them.
+			// The resource has no id attribute, so the import ID is the
+			// bucket name and imported state is matched on name.
+			{
+				ResourceName:                         "s3_bucket.test",
+				ImportState:                          true,
+				ImportStateId:                        bucketName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"force_destroy", "timeouts", "date", "last_updated"},
+			},
+			// Update: a changed tag value in the configuration is applied to
+			// both tags and tags_all.
+			{
+				Config: testAccProviderConfig() + testAccBucketResourceConfig(bucketName, map[string]string{"Environment": "staging"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("s3_bucket.test", "tags.Environment", "staging"),
+					resource.TestCheckResourceAttr("s3_bucket.test", "tags_all.Environment", "staging"),
+				),
+			},
+			// force_destroy lets a non-empty bucket be removed on destroy.
+			// An object is put in the bucket directly via the AWS SDK,
+			// outside Terraform, so it's still there when the test's final
+			// implicit destroy runs: a Terraform-managed s3_bucket_object
+			// would be destroyed before the bucket by Terraform's own
+			// destroy graph, never exercising emptyBucket's
+			// non-empty-bucket deletion path.
+			{
+				Config: testAccProviderConfig() + testAccBucketResourceForceDestroyConfig(bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("s3_bucket.test", "force_destroy", "true"),
+					testAccPutObjectOutOfBand("s3_bucket.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketResourceConfig(name string, tags map[string]string) string {
+	var tagLines strings.Builder
+	for k, v := range tags {
+		fmt.Fprintf(&tagLines, "    %q = %q\n", k, v)
+	}
+
+	return fmt.Sprintf(`
+resource "s3_bucket" "test" {
+  name = %q
+  tags = {
+%s  }
+}
+`, name, tagLines.String())
+}
+
+func testAccBucketResourceForceDestroyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "s3_bucket" "test" {
+  name          = %q
+  force_destroy = true
+}
+`, name)
+}
+
+// testAccPutObjectOutOfBand uploads an object directly through the AWS SDK
+// against the LocalStack fixture, bypassing Terraform entirely.
+func testAccPutObjectOutOfBand(bucketResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[bucketResourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", bucketResourceName)
+		}
+		bucketName := rs.Primary.Attributes["name"]
+
+		ctx := context.Background()
+		cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion("us-east-1"))
+		if err != nil {
+			return err
+		}
+		client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+			o.UsePathStyle = true
+			o.EndpointResolverWithOptions = aws.EndpointResolverWithOptionsFunc(
+				func(_, region string, _ ...interface{}) (aws.Endpoint, error) {
+					return aws.Endpoint{URL: localstackEndpoint, SigningRegion: region, HostnameImmutable: true}, nil
+				},
+			)
+		})
+
+		_, err = client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String("force-destroy-test.txt"),
+			Body:   strings.NewReader("force destroy coverage"),
+		})
+		return err
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &bucketServerSideEncryptionConfigurationResource{}
+	_ resource.ResourceWithConfigure = &bucketServerSideEncryptionConfigurationResource{}
+)
+
+// NewBucketServerSideEncryptionConfigurationResource is a helper function to simplify the provider implementation.
+func NewBucketServerSideEncryptionConfigurationResource() resource.Resource {
+	return &bucketServerSideEncryptionConfigurationResource{}
+}
+
+// bucketServerSideEncryptionConfigurationResource is the resource implementation.
+type bucketServerSideEncryptionConfigurationResource struct {
+	client *s3Client
+}
+
+// bucketServerSideEncryptionConfigurationResourceModel maps the resource schema data.
+type bucketServerSideEncryptionConfigurationResourceModel struct {
+	Bucket         types.String `tfsdk:"bucket"`
+	SSEAlgorithm   types.String `tfsdk:"sse_algorithm"`
+	KMSMasterKeyID types.String `tfsdk:"kms_master_key_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *bucketServerSideEncryptionConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_server_side_encryption_configuration"
+}
+
+// Schema defines the schema for the resource.
+func (r *bucketServerSideEncryptionConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the default server-side encryption configuration of an s3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Description: "Name of the bucket to configure.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sse_algorithm": schema.StringAttribute{
+				Description: "Server-side encryption algorithm to use: AES256 or aws:kms.",
+				Required:    true,
+			},
+			"kms_master_key_id": schema.StringAttribute{
+				Description: "AWS KMS master key ID used for the SSE-KMS encryption when sse_algorithm is aws:kms. Left out of the request when unset; its presence is not checked against sse_algorithm.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// putEncryption applies the plan's encryption rule to the bucket.
+func (r *bucketServerSideEncryptionConfigurationResource) putEncryption(ctx context.Context, plan bucketServerSideEncryptionConfigurationResourceModel) error {
+	defaultRule := &s3types.ServerSideEncryptionByDefault{
+		SSEAlgorithm: s3types.ServerSideEncryption(plan.SSEAlgorithm.ValueString()),
+	}
+	if !plan.KMSMasterKeyID.IsNull() && plan.KMSMasterKeyID.ValueString() != "" {
+		defaultRule.KMSMasterKeyID = aws.String(plan.KMSMasterKeyID.ValueString())
+	}
+
+	_, err := r.client.Client.PutBucketEncryption(ctx, &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(plan.Bucket.ValueString()),
+		ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+			Rules: []s3types.ServerSideEncryptionRule{
+				{ApplyServerSideEncryptionByDefault: defaultRule},
+			},
+		},
+	})
+	return err
+}
+
+// Create a new resource.
+func (r *bucketServerSideEncryptionConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketServerSideEncryptionConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putEncryption(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting bucket encryption",
+			"Could not set encryption configuration on bucket "+plan.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *bucketServerSideEncryptionConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketServerSideEncryptionConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := r.client.Client.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(state.Bucket.ValueString()),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchBucket" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading bucket encryption",
+			"Could not read encryption configuration for bucket "+state.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if output.ServerSideEncryptionConfiguration != nil && len(output.ServerSideEncryptionConfiguration.Rules) > 0 {
+		defaultRule := output.ServerSideEncryptionConfiguration.Rules[0].ApplyServerSideEncryptionByDefault
+		state.SSEAlgorithm = types.StringValue(string(defaultRule.SSEAlgorithm))
+		state.KMSMasterKeyID = types.StringValue(aws.ToString(defaultRule.KMSMasterKeyID))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update applies a changed encryption rule.
+func (r *bucketServerSideEncryptionConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bucketServerSideEncryptionConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putEncryption(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating bucket encryption",
+			"Could not set encryption configuration on bucket "+plan.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the encryption configuration from the bucket.
+func (r *bucketServerSideEncryptionConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketServerSideEncryptionConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Client.DeleteBucketEncryption(ctx, &s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(state.Bucket.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting bucket encryption",
+			"Could not delete encryption configuration for bucket "+state.Bucket.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bucketServerSideEncryptionConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := resolveS3Client(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client != nil {
+		r.client = client
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// resolveS3Client extracts the provider-configured *s3Client from
+// ProviderData, returning a diagnostic if the type assertion fails. Every
+// resource and data source Configure method calls this instead of
+// duplicating the type assertion and error message.
+func resolveS3Client(providerData any) (*s3Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if providerData == nil {
+		return nil, diags
+	}
+
+	client, ok := providerData.(*s3Client)
+	if !ok {
+		diags.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *s3Client, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil, diags
+	}
+
+	return client, diags
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ provider.Provider = &s3Provider{}
+
+// New is a helper function to simplify provider server and testing implementation.
+func New() func() provider.Provider {
+	return func() provider.Provider {
+		return &s3Provider{}
+	}
+}
+
+// s3Provider is the provider implementation.
+type s3Provider struct{}
+
+// s3ProviderModel maps the provider schema data.
+type s3ProviderModel struct {
+	DefaultTags               *defaultTagsModel `tfsdk:"default_tags"`
+	Endpoint                  types.String      `tfsdk:"endpoint"`
+	Region                    types.String      `tfsdk:"region"`
+	S3ForcePathStyle          types.Bool        `tfsdk:"s3_force_path_style"`
+	SkipCredentialsValidation types.Bool        `tfsdk:"skip_credentials_validation"`
+	SkipRegionValidation      types.Bool        `tfsdk:"skip_region_validation"`
+	DisableSSL                types.Bool        `tfsdk:"disable_ssl"`
+}
+
+// defaultTagsModel maps the default_tags block data.
+type defaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// s3Client bundles the AWS SDK client with the provider-level configuration
+// that resources and data sources need at CRUD time.
+type s3Client struct {
+	Client      *s3.Client
+	DefaultTags map[string]string
+	// DefaultTagsUnknown is true when default_tags.tags wasn't known at
+	// Configure time (e.g. it references another resource's computed
+	// output). Resources use this to mark tags_all unknown during plan
+	// instead of planning against an empty default tag set.
+	DefaultTagsUnknown bool
+}
+
+// Metadata returns the provider type name.
+func (p *s3Provider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "s3"
+}
+
+// Schema defines the provider-level schema for configuration data.
+func (p *s3Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Interact with AWS S3 buckets.",
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Description: "Custom S3 endpoint URL, e.g. to target MinIO, Ceph, or LocalStack instead of AWS.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "AWS region to use. Defaults to the standard AWS SDK credential chain's region when unset.",
+				Optional:    true,
+			},
+			"s3_force_path_style": schema.BoolAttribute{
+				Description: "Force path-style addressing (https://host/bucket instead of https://bucket.host), required by most S3-compatible implementations.",
+				Optional:    true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip validating that credentials are resolvable at provider configuration time, useful against endpoints that accept any credentials.",
+				Optional:    true,
+			},
+			"skip_region_validation": schema.BoolAttribute{
+				Description: "Skip requiring a resolvable AWS region; falls back to us-east-1 when no region is configured.",
+				Optional:    true,
+			},
+			"disable_ssl": schema.BoolAttribute{
+				Description: "Use plain HTTP instead of HTTPS for an endpoint given without a scheme. An endpoint with an explicit scheme is used as given.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				Description: "Configuration block with resource tag settings to apply across all resources.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						Description: "Resource tags to default across all resources. Resource-level tags win on conflict.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure prepares an S3 client for resources and data sources.
+func (p *s3Provider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var pConfig s3ProviderModel
+	diags := req.Config.Get(ctx, &pConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loadOpts []func(*config.LoadOptions) error
+	if region := pConfig.Region.ValueString(); region != "" {
+		loadOpts = append(loadOpts, config.WithRegion(region))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, loadOpts...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to load AWS SDK configuration", err.Error())
+		return
+	}
+	if cfg.Region == "" {
+		if !pConfig.SkipRegionValidation.ValueBool() {
+			resp.Diagnostics.AddError("Unable to resolve AWS region", "No AWS region is configured and none could be resolved from the environment.")
+			return
+		}
+		cfg.Region = "us-east-1"
+	}
+
+	if !pConfig.SkipCredentialsValidation.ValueBool() {
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			resp.Diagnostics.AddError("Unable to resolve AWS credentials", err.Error())
+			return
+		}
+	}
+
+	if endpoint := pConfig.Endpoint.ValueString(); endpoint != "" {
+		if !strings.Contains(endpoint, "://") {
+			scheme := "https"
+			if pConfig.DisableSSL.ValueBool() {
+				scheme = "http"
+			}
+			endpoint = scheme + "://" + endpoint
+		}
+		cfg.EndpointResolverWithOptions = aws.EndpointResolverWithOptionsFunc(
+			func(_, region string, _ ...interface{}) (aws.Endpoint, error) {
+				return aws.Endpoint{URL: endpoint, SigningRegion: region, HostnameImmutable: true}, nil
+			},
+		)
+	}
+
+	defaultTags := make(map[string]string)
+	defaultTagsUnknown := false
+	if pConfig.DefaultTags != nil {
+		if pConfig.DefaultTags.Tags.IsUnknown() {
+			defaultTagsUnknown = true
+		} else if !pConfig.DefaultTags.Tags.IsNull() {
+			diags = pConfig.DefaultTags.Tags.ElementsAs(ctx, &defaultTags, false)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	client := &s3Client{
+		Client: s3.NewFromConfig(cfg, func(o *s3.Options) {
+			o.UsePathStyle = pConfig.S3ForcePathStyle.ValueBool()
+		}),
+		DefaultTags:        defaultTags,
+		DefaultTagsUnknown: defaultTagsUnknown,
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+}
+
+// Resources defines the resources implemented in the provider.
+func (p *s3Provider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewOrderResource,
+		NewBucketObjectResource,
+		NewBucketVersioningResource,
+		NewBucketLifecycleConfigurationResource,
+		NewBucketServerSideEncryptionConfigurationResource,
+		NewBucketPublicAccessBlockResource,
+		NewBucketPolicyResource,
+	}
+}
+
+// DataSources defines the data sources implemented in the provider.
+func (p *s3Provider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewBucketDataSource,
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// localstackEndpoint is populated by TestMain and points acceptance tests
+// at the LocalStack container's mapped S3 port.
+var localstackEndpoint string
+
+// testAccProtoV6ProviderFactories is used to instantiate the provider
+// during acceptance testing.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"s3": providerserver.NewProtocol6WithError(New()()),
+}
+
+// TestMain starts a LocalStack container before any acceptance test runs
+// and tears it down afterward, so tests exercise a real S3 API without
+// needing AWS credentials or network access.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") == "" {
+		os.Exit(m.Run())
+	}
+
+	ctx := context.Background()
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "localstack/localstack:3",
+			ExposedPorts: []string{"4566/tcp"},
+			Env:          map[string]string{"SERVICES": "s3"},
+			WaitingFor:   wait.ForListeningPort("4566/tcp"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		log.Fatalf("failed to start localstack container: %v", err)
+	}
+	defer container.Terminate(ctx) //nolint:errcheck
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		log.Fatalf("failed to get localstack container host: %v", err)
+	}
+	port, err := container.MappedPort(ctx, "4566")
+	if err != nil {
+		log.Fatalf("failed to get localstack container port: %v", err)
+	}
+	localstackEndpoint = fmt.Sprintf("http://%s:%s", host, port.Port())
+
+	os.Setenv("AWS_ACCESS_KEY_ID", "test")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+
+	os.Exit(m.Run())
+}
+
+// testAccPreCheck ensures acceptance tests are only run when explicitly
+// requested via TF_ACC, per the convention used by resource.Test.
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("set TF_ACC=1 to run acceptance tests against the LocalStack container")
+	}
+}
+
+// testAccProviderConfig returns a provider block pointed at the LocalStack
+// container started by TestMain.
+func testAccProviderConfig() string {
+	return fmt.Sprintf(`
+provider "s3" {
+  endpoint                    = %q
+  region                      = "us-east-1"
+  s3_force_path_style         = true
+  skip_credentials_validation = true
+  skip_region_validation      = true
+}
+`, localstackEndpoint)
+}
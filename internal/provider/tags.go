@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// tagsToS3 converts a tag map into the []types.Tag shape the S3 API expects.
+func tagsToS3(tags map[string]string) []s3types.Tag {
+	s3Tags := make([]s3types.Tag, 0, len(tags))
+	for k, v := range tags {
+		s3Tags = append(s3Tags, s3types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return s3Tags
+}
+
+// mergeTags merges the provider-level default tags with the resource-level
+// tags, with resource tags taking precedence on key conflicts. This mirrors
+// the default_tags behavior of the AWS provider.
+func mergeTags(defaultTags, resourceTags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaultTags)+len(resourceTags))
+	for k, v := range defaultTags {
+		merged[k] = v
+	}
+	for k, v := range resourceTags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// tagsEqual reports whether two tag maps contain the same keys and values.
+func tagsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}